@@ -0,0 +1,36 @@
+package main
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+
+	"scalarize/joel/internal/api"
+	"scalarize/joel/internal/model"
+	"scalarize/joel/internal/repo"
+)
+
+func main() {
+	db, _ := gorm.Open("sqlite3", "./gorm.db")
+	defer db.Close()
+
+	db.AutoMigrate(&model.Notification{})
+	db.AutoMigrate(&model.DeliveryAttempt{})
+	db.AutoMigrate(&model.User{})
+	db.AutoMigrate(&model.NotificationType{})
+	db.AutoMigrate(&model.NotificationTarget{})
+	db.AutoMigrate(&model.NotificationTypeDefault{})
+	db.AutoMigrate(&model.NotificationPreference{})
+	db.AutoMigrate(&model.Thread{})
+	db.AutoMigrate(&model.NotificationRead{})
+	db.AutoMigrate(&model.Rule{})
+
+	notifications := repo.NewNotificationRepository(db)
+	server := api.NewServer(notifications, db)
+
+	go server.StartScheduler()
+
+	r := gin.Default()
+	server.RegisterRoutes(r)
+	r.Run(":8080")
+}
@@ -0,0 +1,22 @@
+package api
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+
+	"scalarize/joel/internal/model"
+)
+
+const maxDeliveryAttempts = 5
+
+func (s *Server) ListDeliveries(c *gin.Context) {
+	var deliveries []model.DeliveryAttempt
+	id := c.Params.ByName("id")
+	if err := s.DB.Where("notification_id = ?", id).Find(&deliveries).Error; err != nil {
+		c.AbortWithStatus(404)
+		fmt.Println(err)
+	} else {
+		c.JSON(200, deliveries)
+	}
+}
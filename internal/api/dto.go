@@ -0,0 +1,37 @@
+package api
+
+// NotificationCreate is the validated input payload for POST /notification/.
+type NotificationCreate struct {
+	Message	string	`json:"message" validate:"required,max=1000"`
+	Tags	string	`json:"tags" validate:"max=255"`
+	NotifyTime	uint	`json:"notify_time" validate:"required,futuretime"`
+	Status	uint	`json:"status" validate:"omitempty,oneof=0 1 2"`
+	Target	string	`json:"target" validate:"required,deliverytarget"`
+	UserID	uint	`json:"user_id"`
+	NotificationTypeID	uint	`json:"notification_type_id"`
+	ThreadID	uint	`json:"thread_id"`
+}
+
+// NotificationUpdate is the validated input payload for PUT /notification/:id.
+// All fields are optional; zero values are left unchanged by the handler.
+type NotificationUpdate struct {
+	Message	string	`json:"message" validate:"omitempty,max=1000"`
+	Tags	string	`json:"tags" validate:"max=255"`
+	NotifyTime	uint	`json:"notify_time" validate:"omitempty,futuretime"`
+	Status	*uint	`json:"status" validate:"omitempty,oneof=0 1 2"`
+	Target	string	`json:"target" validate:"omitempty,deliverytarget"`
+}
+
+// NotificationOut is the JSON shape returned to clients; it is mapped from
+// model.Notification so the database entity is never serialized directly.
+type NotificationOut struct {
+	ID	uint	`json:"id"`
+	Message	string	`json:"message"`
+	Tags	string	`json:"tags"`
+	NotifyTime	uint	`json:"notify_time"`
+	Status	uint	`json:"status"`
+	Target	string	`json:"target"`
+	UserID	uint	`json:"user_id"`
+	NotificationTypeID	uint	`json:"notification_type_id"`
+	ThreadID	uint	`json:"thread_id"`
+}
@@ -0,0 +1,31 @@
+package api
+
+import "scalarize/joel/internal/model"
+
+// MapFunc builds a slice-mapping function from a single-item mapper, so DB
+// entities are converted to their output DTO in one place.
+func MapFunc[T, U any](fn func(T) U) func([]T) []U {
+	return func(in []T) []U {
+		out := make([]U, len(in))
+		for i, v := range in {
+			out[i] = fn(v)
+		}
+		return out
+	}
+}
+
+func toNotificationOut(n model.Notification) NotificationOut {
+	return NotificationOut{
+		ID:	n.ID,
+		Message:	n.Message,
+		Tags:	n.Tags,
+		NotifyTime:	n.NotifyTime,
+		Status:	n.Status,
+		Target:	n.Target,
+		UserID:	n.UserID,
+		NotificationTypeID:	n.NotificationTypeID,
+		ThreadID:	n.ThreadID,
+	}
+}
+
+var mapNotifications = MapFunc(toNotificationOut)
@@ -0,0 +1,131 @@
+package api
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+
+	"scalarize/joel/internal/model"
+	"scalarize/joel/internal/repo"
+)
+
+func (s *Server) ListNotifications(c *gin.Context) {
+	notifications, err := s.Notifications.List(repo.NotificationFilter{})
+	if err != nil {
+		c.AbortWithStatus(404)
+		fmt.Println(err)
+		return
+	}
+
+	c.JSON(200, mapNotifications(notifications))
+}
+
+func (s *Server) GetNotification(c *gin.Context) {
+	id := atouint(c.Params.ByName("id"))
+	notification, err := s.Notifications.GetByID(id)
+	if err != nil {
+		c.AbortWithStatus(404)
+		fmt.Println(err)
+		return
+	}
+
+	c.JSON(200, toNotificationOut(*notification))
+}
+
+func (s *Server) CreateNotification(c *gin.Context) {
+	var in NotificationCreate
+	c.BindJSON(&in)
+
+	if err := s.validate.Struct(in); err != nil {
+		c.AbortWithStatus(400)
+		fmt.Println(err)
+		return
+	}
+
+	notification := model.Notification{
+		Message:	in.Message,
+		Tags:	in.Tags,
+		NotifyTime:	in.NotifyTime,
+		Status:	in.Status,
+		Target:	in.Target,
+		UserID:	in.UserID,
+		NotificationTypeID:	in.NotificationTypeID,
+		ThreadID:	in.ThreadID,
+	}
+
+	if err := s.Notifications.Create(&notification); err != nil {
+		c.AbortWithStatus(400)
+		fmt.Println(err)
+		return
+	}
+
+	if err := s.RunRulePipeline(notification.ID); err != nil {
+		fmt.Println(err)
+	}
+
+	c.JSON(200, toNotificationOut(notification))
+}
+
+func (s *Server) UpdateNotification(c *gin.Context) {
+	id := atouint(c.Params.ByName("id"))
+	notification, err := s.Notifications.GetByID(id)
+	if err != nil {
+		c.AbortWithStatus(404)
+		fmt.Println(err)
+		return
+	}
+
+	var in NotificationUpdate
+	c.BindJSON(&in)
+
+	if err := s.validate.Struct(in); err != nil {
+		c.AbortWithStatus(400)
+		fmt.Println(err)
+		return
+	}
+
+	if in.Message != "" {
+		notification.Message = in.Message
+	}
+	if in.Tags != "" {
+		notification.Tags = in.Tags
+	}
+	if in.NotifyTime != 0 {
+		notification.NotifyTime = in.NotifyTime
+	}
+	if in.Target != "" {
+		notification.Target = in.Target
+	}
+	if in.Status != nil {
+		notification.Status = *in.Status
+	}
+
+	if err := s.Notifications.Update(notification); err != nil {
+		c.AbortWithStatus(400)
+		fmt.Println(err)
+		return
+	}
+
+	if err := s.RunRulePipeline(notification.ID); err != nil {
+		fmt.Println(err)
+	}
+
+	c.JSON(200, toNotificationOut(*notification))
+}
+
+func (s *Server) DeleteNotification(c *gin.Context) {
+	id := atouint(c.Params.ByName("id"))
+	if _, err := s.Notifications.GetByID(id); err != nil {
+		c.AbortWithStatus(404)
+		fmt.Println(err)
+		return
+	}
+
+	if err := s.Notifications.Delete(id); err != nil {
+		c.AbortWithStatus(400)
+		fmt.Println(err)
+		return
+	}
+
+	c.JSON(200, gin.H{"notification #" + c.Params.ByName("id"): "deleted"})
+}
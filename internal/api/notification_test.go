@@ -0,0 +1,128 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+
+	"scalarize/joel/internal/model"
+	"scalarize/joel/internal/repo"
+)
+
+// fakeNotificationRepository is an in-memory repo.NotificationRepository,
+// the substitution the chunk0-5 request asked the interface to enable.
+type fakeNotificationRepository struct {
+	notifications map[uint]model.Notification
+}
+
+func newFakeNotificationRepository() *fakeNotificationRepository {
+	return &fakeNotificationRepository{notifications: map[uint]model.Notification{}}
+}
+
+func (f *fakeNotificationRepository) Create(n *model.Notification) error {
+	n.ID = uint(len(f.notifications) + 1)
+	f.notifications[n.ID] = *n
+	return nil
+}
+
+func (f *fakeNotificationRepository) GetByID(id uint) (*model.Notification, error) {
+	n, ok := f.notifications[id]
+	if !ok {
+		return nil, gorm404{}
+	}
+	return &n, nil
+}
+
+func (f *fakeNotificationRepository) List(repo.NotificationFilter) ([]model.Notification, error) {
+	out := make([]model.Notification, 0, len(f.notifications))
+	for _, n := range f.notifications {
+		out = append(out, n)
+	}
+	return out, nil
+}
+
+func (f *fakeNotificationRepository) Update(n *model.Notification) error {
+	f.notifications[n.ID] = *n
+	return nil
+}
+
+func (f *fakeNotificationRepository) Delete(id uint) error {
+	delete(f.notifications, id)
+	return nil
+}
+
+func (f *fakeNotificationRepository) MarkStatus(id uint, status uint) error {
+	n := f.notifications[id]
+	n.Status = status
+	f.notifications[id] = n
+	return nil
+}
+
+type gorm404 struct{}
+
+func (gorm404) Error() string { return "record not found" }
+
+func newTestServer(t *testing.T, fake *fakeNotificationRepository) *Server {
+	t.Helper()
+
+	db, err := gorm.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("opening in-memory db: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	db.AutoMigrate(&model.Rule{})
+	db.AutoMigrate(&model.Notification{})
+
+	return NewServer(fake, db)
+}
+
+func TestUpdateNotificationLeavesOmittedStatusUnchanged(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	fake := newFakeNotificationRepository()
+	fake.notifications[1] = model.Notification{ID: 1, Message: "hi", Status: model.StatusSent, Target: "smtp://example.com"}
+	server := newTestServer(t, fake)
+
+	r := gin.New()
+	r.PUT("/notification/:id", server.UpdateNotification)
+
+	body, _ := json.Marshal(map[string]string{"message": "updated"})
+	req := httptest.NewRequest(http.MethodPut, "/notification/1", bytes.NewReader(body))
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", w.Code, w.Body.String())
+	}
+
+	got := fake.notifications[1]
+	if got.Status != model.StatusSent {
+		t.Fatalf("expected Status to remain StatusSent, got %d", got.Status)
+	}
+	if got.Message != "updated" {
+		t.Fatalf("expected Message to be updated, got %q", got.Message)
+	}
+}
+
+func TestGetNotificationNotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	server := newTestServer(t, newFakeNotificationRepository())
+
+	r := gin.New()
+	r.GET("/notification/:id", server.GetNotification)
+
+	req := httptest.NewRequest(http.MethodGet, "/notification/1", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected 404, got %d", w.Code)
+	}
+}
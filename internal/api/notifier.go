@@ -0,0 +1,164 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"scalarize/joel/internal/model"
+)
+
+// Notifier delivers a single Notification through one transport backend.
+type Notifier interface {
+	Send(notification *model.Notification) error
+}
+
+var notifierSchemes = []string{"smtp", "slack", "discord", "webhook+https", "webhook+http", "telegram"}
+
+// validateTarget checks that target is a URL with a scheme we can dispatch through.
+func validateTarget(target string) error {
+	if target == "" {
+		return errors.New("target is required")
+	}
+
+	u, err := url.Parse(target)
+	if err != nil {
+		return err
+	}
+
+	for _, scheme := range notifierSchemes {
+		if u.Scheme == scheme {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unsupported target scheme %q", u.Scheme)
+}
+
+// NotifierFor resolves the Notifier backend for a notification's target URL.
+func NotifierFor(target string) (Notifier, error) {
+	u, err := url.Parse(target)
+	if err != nil {
+		return nil, err
+	}
+
+	switch u.Scheme {
+	case "smtp":
+		return &SMTPNotifier{URL: u}, nil
+	case "slack":
+		return &SlackNotifier{URL: u}, nil
+	case "discord":
+		return &DiscordNotifier{URL: u}, nil
+	case "webhook+https", "webhook+http":
+		return &WebhookNotifier{URL: u}, nil
+	case "telegram":
+		return &TelegramNotifier{URL: u}, nil
+	default:
+		return nil, fmt.Errorf("unsupported target scheme %q", u.Scheme)
+	}
+}
+
+// SMTPNotifier is the Notifier for smtp:// targets. Its Send sends no email:
+// it always returns an error, so every attempt is recorded as failed.
+type SMTPNotifier struct {
+	URL *url.URL
+}
+
+func (n *SMTPNotifier) Send(notification *model.Notification) error {
+	// TODO: wire up a real SMTP client. Until then, report failure rather
+	// than silently recording delivery attempts as sent.
+	return fmt.Errorf("smtp: transport not yet implemented (target %s)", n.URL.Host)
+}
+
+// SlackNotifier posts a notification to a Slack incoming webhook.
+type SlackNotifier struct {
+	URL *url.URL
+}
+
+func (n *SlackNotifier) Send(notification *model.Notification) error {
+	payload, err := json.Marshal(map[string]string{"text": notification.Message})
+	if err != nil {
+		return err
+	}
+
+	resp, err := http.Post("https://"+n.URL.Host+n.URL.Path, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("slack: unexpected status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// DiscordNotifier posts a notification to a Discord webhook.
+type DiscordNotifier struct {
+	URL *url.URL
+}
+
+func (n *DiscordNotifier) Send(notification *model.Notification) error {
+	payload, err := json.Marshal(map[string]string{"content": notification.Message})
+	if err != nil {
+		return err
+	}
+
+	resp, err := http.Post("https://"+n.URL.Host+n.URL.Path, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("discord: unexpected status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// WebhookNotifier posts a notification to an arbitrary webhook+http(s):// target.
+type WebhookNotifier struct {
+	URL *url.URL
+}
+
+func (n *WebhookNotifier) Send(notification *model.Notification) error {
+	scheme := "https"
+	if n.URL.Scheme == "webhook+http" {
+		scheme = "http"
+	}
+
+	payload, err := json.Marshal(toNotificationOut(*notification))
+	if err != nil {
+		return err
+	}
+
+	resp, err := http.Post(scheme+"://"+n.URL.Host+n.URL.Path, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook: unexpected status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// TelegramNotifier is the Notifier for telegram:// targets. Its Send makes no
+// Telegram bot API call: it always returns an error, so every attempt is
+// recorded as failed.
+type TelegramNotifier struct {
+	URL *url.URL
+}
+
+func (n *TelegramNotifier) Send(notification *model.Notification) error {
+	// TODO: wire up the Telegram bot API. Until then, report failure rather
+	// than silently recording delivery attempts as sent.
+	return fmt.Errorf("telegram: transport not yet implemented (chat %s)", n.URL.Host)
+}
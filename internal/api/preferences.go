@@ -0,0 +1,187 @@
+package api
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/gin-gonic/gin"
+
+	"scalarize/joel/internal/model"
+)
+
+func (s *Server) GetNotificationPreferences(c *gin.Context) {
+	var preferences []model.NotificationPreference
+	userID := c.Params.ByName("id")
+	if err := s.DB.Where("user_id = ?", userID).Find(&preferences).Error; err != nil {
+		c.AbortWithStatus(404)
+		fmt.Println(err)
+	} else {
+		c.JSON(200, preferences)
+	}
+}
+
+func (s *Server) PutNotificationPreferences(c *gin.Context) {
+	userID := c.Params.ByName("id")
+
+	var preferences []model.NotificationPreference
+	c.BindJSON(&preferences)
+
+	for i := range preferences {
+		preferences[i].UserID = atouint(userID)
+
+		var existing model.NotificationPreference
+		err := s.DB.Where("user_id = ? AND notification_type_id = ? AND notification_target_id = ?",
+			preferences[i].UserID, preferences[i].NotificationTypeID, preferences[i].NotificationTargetID).
+			First(&existing).Error
+
+		if err != nil {
+			s.DB.Create(&preferences[i])
+		} else {
+			existing.Enabled = preferences[i].Enabled
+			s.DB.Save(&existing)
+		}
+	}
+
+	c.JSON(200, preferences)
+}
+
+// isEnabled reports whether a user wants to receive a notification of the
+// given type on the given target, falling back to the system-wide default.
+func (s *Server) isEnabled(userID, notificationTypeID, notificationTargetID uint) bool {
+	var preference model.NotificationPreference
+	if err := s.DB.Where("user_id = ? AND notification_type_id = ? AND notification_target_id = ?",
+		userID, notificationTypeID, notificationTargetID).First(&preference).Error; err == nil {
+		return preference.Enabled
+	}
+
+	var def model.NotificationTypeDefault
+	if err := s.DB.Where("notification_type_id = ? AND notification_target_id = ?",
+		notificationTypeID, notificationTargetID).First(&def).Error; err == nil {
+		return def.Enabled
+	}
+
+	return true
+}
+
+var schemeTargetNames = map[string]string{
+	"smtp":	"email",
+	"slack":	"webhook",
+	"discord":	"webhook",
+	"webhook+https":	"webhook",
+	"webhook+http":	"webhook",
+	"telegram":	"webhook",
+}
+
+// targetIDForScheme resolves the NotificationTarget that a delivery target
+// URL's scheme routes through (e.g. smtp:// -> "email"), lazily creating the
+// canonical row by name if an admin hasn't seeded it yet. The preference
+// gate in dispatch must always be able to resolve a target id for a known
+// scheme — if this returned 0 whenever the row was missing, an unseeded
+// NotificationTarget would silently bypass a user's preference instead of
+// just falling back to the system default.
+func (s *Server) targetIDForScheme(target string) uint {
+	u, err := url.Parse(target)
+	if err != nil {
+		return 0
+	}
+
+	name, ok := schemeTargetNames[u.Scheme]
+	if !ok {
+		return 0
+	}
+
+	var notificationTarget model.NotificationTarget
+	if err := s.DB.Where("name = ?", name).FirstOrCreate(&notificationTarget, model.NotificationTarget{Name: name}).Error; err != nil {
+		return 0
+	}
+
+	return notificationTarget.ID
+}
+
+func (s *Server) ListNotificationTypes(c *gin.Context) {
+	var types []model.NotificationType
+	if err := s.DB.Find(&types).Error; err != nil {
+		c.AbortWithStatus(404)
+		fmt.Println(err)
+	} else {
+		c.JSON(200, types)
+	}
+}
+
+func (s *Server) CreateNotificationType(c *gin.Context) {
+	var notificationType model.NotificationType
+	c.BindJSON(&notificationType)
+
+	s.DB.Create(&notificationType)
+	c.JSON(200, notificationType)
+}
+
+func (s *Server) UpdateNotificationType(c *gin.Context) {
+	var notificationType model.NotificationType
+	id := c.Params.ByName("id")
+	if err := s.DB.Where("id = ?", id).First(&notificationType).Error; err != nil {
+		c.AbortWithStatus(404)
+		fmt.Println(err)
+		return
+	}
+
+	c.BindJSON(&notificationType)
+	s.DB.Save(&notificationType)
+	c.JSON(200, notificationType)
+}
+
+func (s *Server) DeleteNotificationType(c *gin.Context) {
+	var notificationType model.NotificationType
+	id := c.Params.ByName("id")
+	if err := s.DB.Where("id = ?", id).First(&notificationType).Error; err != nil {
+		c.AbortWithStatus(404)
+		fmt.Println(err)
+	} else {
+		s.DB.Delete(&notificationType)
+		c.JSON(200, gin.H{"notification type #" + id: "deleted"})
+	}
+}
+
+func (s *Server) ListNotificationTargets(c *gin.Context) {
+	var targets []model.NotificationTarget
+	if err := s.DB.Find(&targets).Error; err != nil {
+		c.AbortWithStatus(404)
+		fmt.Println(err)
+	} else {
+		c.JSON(200, targets)
+	}
+}
+
+func (s *Server) CreateNotificationTarget(c *gin.Context) {
+	var target model.NotificationTarget
+	c.BindJSON(&target)
+
+	s.DB.Create(&target)
+	c.JSON(200, target)
+}
+
+func (s *Server) UpdateNotificationTarget(c *gin.Context) {
+	var target model.NotificationTarget
+	id := c.Params.ByName("id")
+	if err := s.DB.Where("id = ?", id).First(&target).Error; err != nil {
+		c.AbortWithStatus(404)
+		fmt.Println(err)
+		return
+	}
+
+	c.BindJSON(&target)
+	s.DB.Save(&target)
+	c.JSON(200, target)
+}
+
+func (s *Server) DeleteNotificationTarget(c *gin.Context) {
+	var target model.NotificationTarget
+	id := c.Params.ByName("id")
+	if err := s.DB.Where("id = ?", id).First(&target).Error; err != nil {
+		c.AbortWithStatus(404)
+		fmt.Println(err)
+	} else {
+		s.DB.Delete(&target)
+		c.JSON(200, gin.H{"notification target #" + id: "deleted"})
+	}
+}
@@ -0,0 +1,259 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/itchyny/gojq"
+
+	"scalarize/joel/internal/model"
+)
+
+// Actor is a single action a matching rule performs on a notification entry.
+type Actor struct {
+	Name	string	`json:"name"`
+	Tag	string	`json:"tag,omitempty"`
+	Target	string	`json:"target,omitempty"`
+	SnoozeUntil	uint	`json:"snooze_until,omitempty"`
+	WebhookURL	string	`json:"webhook_url,omitempty"`
+}
+
+func (s *Server) ListRules(c *gin.Context) {
+	var rules []model.Rule
+	if err := s.DB.Order("priority").Find(&rules).Error; err != nil {
+		c.AbortWithStatus(404)
+		fmt.Println(err)
+	} else {
+		c.JSON(200, rules)
+	}
+}
+
+func (s *Server) CreateRule(c *gin.Context) {
+	var rule model.Rule
+	c.BindJSON(&rule)
+
+	s.DB.Create(&rule)
+	c.JSON(200, rule)
+}
+
+func (s *Server) UpdateRule(c *gin.Context) {
+	var rule model.Rule
+	id := c.Params.ByName("id")
+	if err := s.DB.Where("id = ?", id).First(&rule).Error; err != nil {
+		c.AbortWithStatus(404)
+		fmt.Println(err)
+		return
+	}
+
+	c.BindJSON(&rule)
+	s.DB.Save(&rule)
+	c.JSON(200, rule)
+}
+
+func (s *Server) DeleteRule(c *gin.Context) {
+	var rule model.Rule
+	id := c.Params.ByName("id")
+	if err := s.DB.Where("id = ?", id).First(&rule).Error; err != nil {
+		c.AbortWithStatus(404)
+		fmt.Println(err)
+	} else {
+		s.DB.Delete(&rule)
+		c.JSON(200, gin.H{"rule #" + id: "deleted"})
+	}
+}
+
+// PreviewRule handles POST /rules/:id/preview: it runs the rule against
+// every notification without persisting any change, and returns the ids
+// of the notifications that would be affected.
+func (s *Server) PreviewRule(c *gin.Context) {
+	var rule model.Rule
+	id := c.Params.ByName("id")
+	if err := s.DB.Where("id = ?", id).First(&rule).Error; err != nil {
+		c.AbortWithStatus(404)
+		fmt.Println(err)
+		return
+	}
+
+	var notifications []model.Notification
+	if err := s.DB.Find(&notifications).Error; err != nil {
+		c.AbortWithStatus(404)
+		fmt.Println(err)
+		return
+	}
+
+	entries, err := notificationsToEntries(notifications)
+	if err != nil {
+		c.AbortWithStatus(500)
+		fmt.Println(err)
+		return
+	}
+
+	matched, err := matchingIDs(rule, entries)
+	if err != nil {
+		c.AbortWithStatus(400)
+		fmt.Println(err)
+		return
+	}
+
+	c.JSON(200, gin.H{"matched": matched})
+}
+
+// RunRulePipeline evaluates each enabled rule's filter, in priority order,
+// against the single notification that triggered this create/update and
+// applies the matching actors to it. Side-effecting actors (e.g. webhook)
+// must fire once per triggering event, so the pipeline is scoped to that
+// one notification rather than every row in the table — re-running it over
+// the whole table on every mutation would re-fire actors for every
+// notification a rule had ever matched. Use PreviewRule to see what a rule
+// would match across the whole table without applying anything.
+func (s *Server) RunRulePipeline(notificationID uint) error {
+	var rules []model.Rule
+	if err := s.DB.Order("priority").Where("enabled = ?", true).Find(&rules).Error; err != nil {
+		return err
+	}
+
+	var notification model.Notification
+	if err := s.DB.Where("id = ?", notificationID).First(&notification).Error; err != nil {
+		return err
+	}
+
+	entries, err := notificationsToEntries([]model.Notification{notification})
+	if err != nil {
+		return err
+	}
+	entry := entries[0]
+
+	changed := false
+
+	for _, rule := range rules {
+		matched, err := matchingIDs(rule, entries)
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+		if len(matched) == 0 {
+			continue
+		}
+
+		var actors []Actor
+		if err := json.Unmarshal([]byte(rule.ActionsJSON), &actors); err != nil {
+			fmt.Println(err)
+			continue
+		}
+
+		for _, actor := range actors {
+			applyActor(actor, entry)
+		}
+		changed = true
+	}
+
+	if changed {
+		if err := s.saveEntry(entry); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func notificationsToEntries(notifications []model.Notification) ([]map[string]interface{}, error) {
+	raw, err := json.Marshal(notifications)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []map[string]interface{}
+	if err := json.Unmarshal(raw, &entries); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func idOf(entry map[string]interface{}) uint {
+	id, _ := entry["id"].(float64)
+	return uint(id)
+}
+
+// matchingIDs evaluates a rule's jq filter over every entry and returns the
+// ids of the entries for which the filter produced a truthy result.
+func matchingIDs(rule model.Rule, entries []map[string]interface{}) ([]uint, error) {
+	query, err := gojq.Parse(rule.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []uint
+	for _, entry := range entries {
+		iter := query.Run(entry)
+		for {
+			v, ok := iter.Next()
+			if !ok {
+				break
+			}
+			if err, isErr := v.(error); isErr {
+				return nil, err
+			}
+			if isTruthy(v) {
+				matched = append(matched, idOf(entry))
+			}
+		}
+	}
+
+	return matched, nil
+}
+
+// isTruthy follows jq's own truthiness rule so idiomatic `select(...)`
+// filters (which emit the matched value, not a bool) count as a match —
+// only null and false are falsy.
+func isTruthy(v interface{}) bool {
+	if v == nil {
+		return false
+	}
+	if b, ok := v.(bool); ok {
+		return b
+	}
+	return true
+}
+
+// applyActor mutates entry in place according to the actor's action.
+func applyActor(actor Actor, entry map[string]interface{}) {
+	switch actor.Name {
+	case "hide":
+		entry["status"] = float64(model.StatusFailed)
+	case "tag":
+		tags, _ := entry["tags"].(string)
+		if tags == "" {
+			entry["tags"] = actor.Tag
+		} else {
+			entry["tags"] = tags + "," + actor.Tag
+		}
+	case "reroute":
+		entry["target"] = actor.Target
+	case "snooze":
+		entry["notify_time"] = float64(actor.SnoozeUntil)
+	case "webhook":
+		notifier, err := NotifierFor(actor.WebhookURL)
+		if err == nil {
+			message, _ := entry["message"].(string)
+			notifier.Send(&model.Notification{Message: message, Target: actor.WebhookURL})
+		}
+	case "debug":
+		fmt.Printf("rule debug: %+v\n", entry)
+	}
+}
+
+func (s *Server) saveEntry(entry map[string]interface{}) error {
+	raw, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	var notification model.Notification
+	if err := json.Unmarshal(raw, &notification); err != nil {
+		return err
+	}
+
+	return s.DB.Save(&notification).Error
+}
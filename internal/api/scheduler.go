@@ -0,0 +1,108 @@
+package api
+
+import (
+	"fmt"
+	"time"
+
+	"scalarize/joel/internal/model"
+)
+
+const schedulerInterval = 10 * time.Second
+
+// StartScheduler polls for due notifications and dispatches them through
+// their target's Notifier, retrying with a backoff on failure. It blocks,
+// so callers run it in its own goroutine.
+func (s *Server) StartScheduler() {
+	ticker := time.NewTicker(schedulerInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		s.dispatchDue()
+	}
+}
+
+func (s *Server) dispatchDue() {
+	var due []model.Notification
+	now := uint(time.Now().Unix())
+
+	if err := s.DB.Where("status = ? AND notify_time <= ?", model.StatusPending, now).Find(&due).Error; err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	// Each notification retries independently in its own goroutine so a
+	// slow/failing target's backoff doesn't delay every other due
+	// notification until the next poll. Claim a row (pending -> in
+	// progress) before handing it off so the next poll, which can overlap
+	// a still-retrying dispatch, doesn't pick up the same notification and
+	// deliver it a second time.
+	for i := range due {
+		if !s.claim(due[i].ID) {
+			continue
+		}
+		go s.dispatch(&due[i])
+	}
+}
+
+// claim atomically marks a pending notification as in progress, returning
+// whether this call was the one that claimed it.
+func (s *Server) claim(id uint) bool {
+	result := s.DB.Model(&model.Notification{}).
+		Where("id = ? AND status = ?", id, model.StatusPending).
+		Update("status", model.StatusInProgress)
+	return result.Error == nil && result.RowsAffected > 0
+}
+
+func (s *Server) dispatch(notification *model.Notification) {
+	if notification.UserID != 0 && notification.NotificationTypeID != 0 {
+		targetID := s.targetIDForScheme(notification.Target)
+		if targetID != 0 && !s.isEnabled(notification.UserID, notification.NotificationTypeID, targetID) {
+			notification.Status = model.StatusFailed
+			s.DB.Save(notification)
+			return
+		}
+	}
+
+	notifier, err := NotifierFor(notification.Target)
+	if err != nil {
+		fmt.Println(err)
+		notification.Status = model.StatusFailed
+		s.DB.Save(notification)
+		return
+	}
+
+	var sendErr error
+	for attempt := uint(1); attempt <= maxDeliveryAttempts; attempt++ {
+		sendErr = notifier.Send(notification)
+
+		var status uint = model.StatusSent
+		errMessage := ""
+		if sendErr != nil {
+			status = model.StatusFailed
+			errMessage = sendErr.Error()
+		}
+
+		s.DB.Create(&model.DeliveryAttempt{
+			NotificationID:	notification.ID,
+			Attempt:	attempt,
+			Status:	status,
+			Error:	errMessage,
+		})
+
+		if sendErr == nil {
+			notification.Status = model.StatusSent
+			s.DB.Save(notification)
+			return
+		}
+
+		time.Sleep(backoff(attempt))
+	}
+
+	notification.Status = model.StatusFailed
+	s.DB.Save(notification)
+}
+
+// backoff returns an exponential delay between retry attempts.
+func backoff(attempt uint) time.Duration {
+	return time.Duration(1<<attempt) * time.Second
+}
@@ -0,0 +1,66 @@
+// Package api wires HTTP handlers to the repository layer.
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/jinzhu/gorm"
+
+	"scalarize/joel/internal/repo"
+)
+
+// Server holds the dependencies handlers need, injected through the
+// constructor so tests can substitute fakes (e.g. an in-memory
+// repo.NotificationRepository).
+type Server struct {
+	Notifications	repo.NotificationRepository
+	DB	*gorm.DB
+	validate	*validator.Validate
+}
+
+// NewServer builds a Server and registers the package's custom validators.
+func NewServer(notifications repo.NotificationRepository, db *gorm.DB) *Server {
+	validate := validator.New()
+	validate.RegisterValidation("deliverytarget", validateDeliveryTarget)
+	validate.RegisterValidation("futuretime", validateFutureTime)
+
+	return &Server{
+		Notifications:	notifications,
+		DB:	db,
+		validate:	validate,
+	}
+}
+
+// RegisterRoutes mounts every handler onto r.
+func (s *Server) RegisterRoutes(r *gin.Engine) {
+	r.GET("/notification/", s.ListNotifications)
+	r.GET("/notification/:id", s.GetNotification)
+	r.POST("/notification/", s.CreateNotification)
+	r.PUT("/notification/:id", s.UpdateNotification)
+	r.DELETE("/notification/:id", s.DeleteNotification)
+	r.GET("/notification/:id/deliveries", s.ListDeliveries)
+
+	r.GET("/notifications", s.ListNotificationsFiltered)
+	r.PUT("/notifications", s.MarkAllRead)
+	r.GET("/notifications/threads/:id", s.GetThread)
+	r.PATCH("/notifications/threads/:id", s.PatchThread)
+
+	r.GET("/users/:id/notification-preferences", s.GetNotificationPreferences)
+	r.PUT("/users/:id/notification-preferences", s.PutNotificationPreferences)
+
+	r.GET("/admin/notification-types", s.ListNotificationTypes)
+	r.POST("/admin/notification-types", s.CreateNotificationType)
+	r.PUT("/admin/notification-types/:id", s.UpdateNotificationType)
+	r.DELETE("/admin/notification-types/:id", s.DeleteNotificationType)
+
+	r.GET("/admin/notification-targets", s.ListNotificationTargets)
+	r.POST("/admin/notification-targets", s.CreateNotificationTarget)
+	r.PUT("/admin/notification-targets/:id", s.UpdateNotificationTarget)
+	r.DELETE("/admin/notification-targets/:id", s.DeleteNotificationTarget)
+
+	r.GET("/rules", s.ListRules)
+	r.POST("/rules", s.CreateRule)
+	r.PUT("/rules/:id", s.UpdateRule)
+	r.DELETE("/rules/:id", s.DeleteRule)
+	r.POST("/rules/:id/preview", s.PreviewRule)
+}
@@ -0,0 +1,110 @@
+package api
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"scalarize/joel/internal/model"
+	"scalarize/joel/internal/repo"
+)
+
+// ListNotificationsFiltered handles GET /notifications with status/tags/time/pagination filters.
+// status=unread|read requires user_id, since read state is tracked per user.
+func (s *Server) ListNotificationsFiltered(c *gin.Context) {
+	status := c.Query("status")
+
+	if (status == "unread" || status == "read") && c.Query("user_id") == "" {
+		c.AbortWithStatus(400)
+		fmt.Println("notifications: user_id is required for status=unread|read")
+		return
+	}
+
+	filter := repo.NotificationFilter{
+		UserID: atouint(c.Query("user_id")),
+		Status: status,
+	}
+
+	if tags := c.Query("tags"); tags != "" {
+		filter.Tags = strings.Split(tags, ",")
+	}
+	if since := c.Query("since"); since != "" {
+		filter.Since = atouint(since)
+	}
+	if until := c.Query("until"); until != "" {
+		filter.Until = atouint(until)
+	}
+	if l, err := strconv.Atoi(c.Query("limit")); err == nil {
+		filter.Limit = l
+	}
+	if p, err := strconv.Atoi(c.Query("page")); err == nil {
+		filter.Page = p
+	}
+
+	notifications, err := s.Notifications.List(filter)
+	if err != nil {
+		c.AbortWithStatus(404)
+		fmt.Println(err)
+		return
+	}
+
+	c.JSON(200, mapNotifications(notifications))
+}
+
+// MarkAllRead handles PUT /notifications, marking all (optionally filtered) notifications as read.
+func (s *Server) MarkAllRead(c *gin.Context) {
+	userID := atouint(c.Query("user_id"))
+
+	var notifications []model.Notification
+	query := s.DB.Model(&model.Notification{})
+	if lastReadAt := c.Query("last_read_at"); lastReadAt != "" {
+		query = query.Where("notify_time <= ?", lastReadAt)
+	}
+
+	if err := query.Find(&notifications).Error; err != nil {
+		c.AbortWithStatus(404)
+		fmt.Println(err)
+		return
+	}
+
+	for _, notification := range notifications {
+		var read model.NotificationRead
+		err := s.DB.Where("notification_id = ? AND user_id = ?", notification.ID, userID).First(&read).Error
+		if err != nil {
+			s.DB.Create(&model.NotificationRead{NotificationID: notification.ID, UserID: userID, Read: true})
+		} else {
+			read.Read = true
+			s.DB.Save(&read)
+		}
+	}
+
+	c.JSON(200, gin.H{"marked": len(notifications)})
+}
+
+func (s *Server) GetThread(c *gin.Context) {
+	var thread model.Thread
+	id := c.Params.ByName("id")
+	if err := s.DB.Where("id = ?", id).First(&thread).Error; err != nil {
+		c.AbortWithStatus(404)
+		fmt.Println(err)
+	} else {
+		c.JSON(200, thread)
+	}
+}
+
+// PatchThread handles PATCH /notifications/threads/:id to change a thread's state (e.g. pinned).
+func (s *Server) PatchThread(c *gin.Context) {
+	var thread model.Thread
+	id := c.Params.ByName("id")
+	if err := s.DB.Where("id = ?", id).First(&thread).Error; err != nil {
+		c.AbortWithStatus(404)
+		fmt.Println(err)
+		return
+	}
+
+	c.BindJSON(&thread)
+	s.DB.Save(&thread)
+	c.JSON(200, thread)
+}
@@ -0,0 +1,8 @@
+package api
+
+import "strconv"
+
+func atouint(s string) uint {
+	n, _ := strconv.ParseUint(s, 10, 64)
+	return uint(n)
+}
@@ -0,0 +1,17 @@
+package api
+
+import (
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// validateFutureTime rejects a notify_time that is not strictly in the future.
+func validateFutureTime(fl validator.FieldLevel) bool {
+	return uint64(fl.Field().Uint()) > uint64(time.Now().Unix())
+}
+
+// validateDeliveryTarget checks that a target is a URL whose scheme we know how to dispatch through.
+func validateDeliveryTarget(fl validator.FieldLevel) bool {
+	return validateTarget(fl.Field().String()) == nil
+}
@@ -0,0 +1,39 @@
+// Package model holds the GORM-backed database entities. Notification
+// responses are mapped to DTOs in internal/api rather than serializing the
+// entity directly; its json tags exist so the rules pipeline can round-trip a
+// Notification through its JSON form. The other entities (DeliveryAttempt,
+// the preference, type and target models, Rule and Thread) are not mapped:
+// their handlers return them as JSON directly.
+package model
+
+import "time"
+
+// Notification statuses.
+const (
+	StatusPending	= 0
+	StatusSent	= 1
+	StatusFailed	= 2
+	StatusInProgress	= 3
+)
+
+type Notification struct {
+	ID	uint	`gorm:"primary_key" json:"id"`
+	Message	string	`json:"message"`
+	Tags	string	`json:"tags"`
+	NotifyTime	uint	`json:"notify_time"`
+	Status	uint	`json:"status"`
+	Target	string	`json:"target"`
+	UserID	uint	`json:"user_id"`
+	NotificationTypeID	uint	`json:"notification_type_id"`
+	ThreadID	uint	`json:"thread_id"`
+}
+
+// DeliveryAttempt records one dispatch attempt for a Notification.
+type DeliveryAttempt struct {
+	ID	uint	`gorm:"primary_key" json:"id"`
+	NotificationID	uint	`json:"notification_id"`
+	Attempt	uint	`json:"attempt"`
+	Status	uint	`json:"status"`
+	Error	string	`json:"error"`
+	CreatedAt	time.Time	`json:"created_at"`
+}
@@ -0,0 +1,38 @@
+package model
+
+// User is the account a Notification and its preferences belong to.
+type User struct {
+	ID	uint	`gorm:"primary_key"`
+	Name	string
+	Email	string
+}
+
+// NotificationType is an admin-managed category of notification (e.g. reminder, alert, digest).
+type NotificationType struct {
+	ID	uint	`gorm:"primary_key"`
+	Name	string
+}
+
+// NotificationTarget is an admin-managed delivery channel (e.g. email, webhook, in-app).
+type NotificationTarget struct {
+	ID	uint	`gorm:"primary_key"`
+	Name	string
+}
+
+// NotificationTypeDefault is the system-wide default for whether a
+// (notification type, target) pair is enabled absent a user override.
+type NotificationTypeDefault struct {
+	ID	uint	`gorm:"primary_key"`
+	NotificationTypeID	uint
+	NotificationTargetID	uint
+	Enabled	bool
+}
+
+// NotificationPreference is a per-user override of a NotificationTypeDefault.
+type NotificationPreference struct {
+	ID	uint	`gorm:"primary_key"`
+	UserID	uint
+	NotificationTypeID	uint
+	NotificationTargetID	uint
+	Enabled	bool
+}
@@ -0,0 +1,12 @@
+package model
+
+// Rule is an ordered, admin-defined filter over notifications plus the
+// actor actions to run on every notification it matches.
+type Rule struct {
+	ID	uint	`gorm:"primary_key"`
+	Name	string
+	Priority	int
+	Filter	string
+	ActionsJSON	string
+	Enabled	bool
+}
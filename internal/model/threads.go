@@ -0,0 +1,18 @@
+package model
+
+// Thread groups notifications that share a source object (e.g. the same issue or comment).
+type Thread struct {
+	ID	uint	`gorm:"primary_key"`
+	Subject	string
+	Pinned	bool
+}
+
+// NotificationRead tracks a single user's read state for a notification,
+// kept separate from the notification's own delivery Status.
+type NotificationRead struct {
+	ID	uint	`gorm:"primary_key"`
+	NotificationID	uint
+	UserID	uint
+	Read	bool
+	ReadAt	uint
+}
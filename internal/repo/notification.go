@@ -0,0 +1,109 @@
+// Package repo abstracts persistence behind interfaces so handlers in
+// internal/api can depend on a contract instead of a concrete database.
+package repo
+
+import (
+	"github.com/jinzhu/gorm"
+
+	"scalarize/joel/internal/model"
+)
+
+// NotificationFilter narrows the results of a List call. UserID scopes the
+// unread/read status filter to that user's own NotificationRead rows.
+type NotificationFilter struct {
+	UserID	uint
+	Status	string
+	Tags	[]string
+	Since	uint
+	Until	uint
+	Page	int
+	Limit	int
+}
+
+// NotificationRepository is the persistence contract for Notification.
+// Handlers depend on this interface rather than *gorm.DB directly so tests
+// can substitute an in-memory fake.
+type NotificationRepository interface {
+	Create(notification *model.Notification) error
+	GetByID(id uint) (*model.Notification, error)
+	List(filter NotificationFilter) ([]model.Notification, error)
+	Update(notification *model.Notification) error
+	Delete(id uint) error
+	MarkStatus(id uint, status uint) error
+}
+
+type gormNotificationRepository struct {
+	db *gorm.DB
+}
+
+// NewNotificationRepository returns a GORM-backed NotificationRepository.
+func NewNotificationRepository(db *gorm.DB) NotificationRepository {
+	return &gormNotificationRepository{db: db}
+}
+
+func (r *gormNotificationRepository) Create(notification *model.Notification) error {
+	return r.db.Create(notification).Error
+}
+
+func (r *gormNotificationRepository) GetByID(id uint) (*model.Notification, error) {
+	var notification model.Notification
+	if err := r.db.Where("id = ?", id).First(&notification).Error; err != nil {
+		return nil, err
+	}
+	return &notification, nil
+}
+
+func (r *gormNotificationRepository) List(filter NotificationFilter) ([]model.Notification, error) {
+	query := r.db.Model(&model.Notification{})
+
+	switch filter.Status {
+	case "unread":
+		query = query.Joins("LEFT JOIN notification_reads ON notification_reads.notification_id = notifications.id AND notification_reads.user_id = ?", filter.UserID).
+			Where("notification_reads.read IS NULL OR notification_reads.read = ?", false)
+	case "read":
+		query = query.Joins("JOIN notification_reads ON notification_reads.notification_id = notifications.id AND notification_reads.user_id = ?", filter.UserID).
+			Where("notification_reads.read = ?", true)
+	case "pinned":
+		query = query.Joins("JOIN threads ON threads.id = notifications.thread_id").
+			Where("threads.pinned = ?", true)
+	}
+
+	for _, tag := range filter.Tags {
+		query = query.Where("tags LIKE ?", "%"+tag+"%")
+	}
+
+	if filter.Since != 0 {
+		query = query.Where("notify_time >= ?", filter.Since)
+	}
+	if filter.Until != 0 {
+		query = query.Where("notify_time <= ?", filter.Until)
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+	page := filter.Page
+	if page <= 0 {
+		page = 1
+	}
+
+	var notifications []model.Notification
+	if err := query.Limit(limit).Offset((page - 1) * limit).Find(&notifications).Error; err != nil {
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+func (r *gormNotificationRepository) Update(notification *model.Notification) error {
+	return r.db.Save(notification).Error
+}
+
+func (r *gormNotificationRepository) Delete(id uint) error {
+	return r.db.Where("id = ?", id).Delete(&model.Notification{}).Error
+}
+
+func (r *gormNotificationRepository) MarkStatus(id uint, status uint) error {
+	return r.db.Model(&model.Notification{}).Where("id = ?", id).Update("status", status).Error
+}